@@ -256,8 +256,10 @@ type Cursor struct {
 	// previously seeked key/value
 	key, value []byte
 
-	config kv.CursorConfig
-	closed bool
+	config  kv.CursorConfig
+	closed  bool
+	seen    int
+	limited bool
 }
 
 // Close sets the closed to closed
@@ -267,6 +269,12 @@ func (c *Cursor) Close() error {
 	return nil
 }
 
+// LimitReached returns true when the cursor stopped because the limit
+// configured via kv.WithCursorLimit was reached.
+func (c *Cursor) LimitReached() bool {
+	return c.limited
+}
+
 // Seek seeks for the first key that matches the prefix provided.
 func (c *Cursor) Seek(prefix []byte) ([]byte, []byte) {
 	if c.closed {
@@ -305,24 +313,31 @@ func (c *Cursor) Last() ([]byte, []byte) {
 
 // Next retrieves the next key in the bucket.
 func (c *Cursor) Next() (k []byte, v []byte) {
-	if c.closed || (c.key != nil && c.missingPrefix(c.key)) {
+	if c.closed || c.limited || (c.key != nil && c.missingPrefix(c.key)) {
 		return nil, nil
 	}
 	// get and unset previously seeked values if they exist
 	k, v, c.key, c.value = c.key, c.value, nil, nil
-	if len(k) > 0 || len(v) > 0 {
-		return
-	}
+	if len(k) == 0 && len(v) == 0 {
+		next := c.cursor.Next
+		if c.config.Direction == kv.CursorDescending {
+			next = c.cursor.Prev
+		}
 
-	next := c.cursor.Next
-	if c.config.Direction == kv.CursorDescending {
-		next = c.cursor.Prev
+		k, v = next()
+		if (len(k) == 0 && len(v) == 0) || c.missingPrefix(k) {
+			return nil, nil
+		}
 	}
 
-	k, v = next()
-	if (len(k) == 0 && len(v) == 0) || c.missingPrefix(k) {
+	// a key remains in range so stop if the limit has been reached
+	if c.config.Limit != nil && c.seen >= *c.config.Limit {
+		c.limited = true
 		return nil, nil
 	}
+
+	c.seen++
+
 	return k, v
 }
 
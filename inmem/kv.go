@@ -254,6 +254,8 @@ func (b *Bucket) getAll(o *kv.CursorHints) ([]kv.Pair, error) {
 type pair struct {
 	kv.Pair
 	err error
+	// limit marks the end of a cursor which stopped at its limit
+	limit bool
 }
 
 // ForwardCursor returns a directional cursor which starts at the provided seeked key
@@ -288,6 +290,7 @@ func (b *Bucket) ForwardCursor(seek []byte, opts ...kv.CursorOption) (kv.Forward
 			fn        = config.Hints.PredicateFn
 			iterate   = b.ascend
 			skipFirst = config.SkipFirst
+			seen      int
 		)
 
 		if config.Direction == kv.CursorDescending {
@@ -324,6 +327,15 @@ func (b *Bucket) ForwardCursor(seek []byte, opts ...kv.CursorOption) (kv.Forward
 				return false
 			}
 
+			// if limit reached then mark the cursor as limited and exit iteration
+			if config.Limit != nil && seen >= *config.Limit {
+				batch = append(batch, pair{limit: true})
+
+				return false
+			}
+
+			seen++
+
 			if fn == nil || fn(j.key, j.value) {
 				batch = append(batch, pair{Pair: kv.Pair{Key: j.key, Value: j.value}})
 			}
@@ -371,6 +383,8 @@ type ForwardCursor struct {
 	closed bool
 	// error found during iteration
 	err error
+	// limit reached during iteration
+	limited bool
 }
 
 // Err returns a non-nil error when an error occurred during cursor iteration.
@@ -378,6 +392,12 @@ func (c *ForwardCursor) Err() error {
 	return c.err
 }
 
+// LimitReached returns true when the cursor stopped because the limit
+// configured via kv.WithCursorLimit was reached.
+func (c *ForwardCursor) LimitReached() bool {
+	return c.limited
+}
+
 // Close releases the producing goroutines for the forward cursor.
 // It blocks until the producing goroutine exits.
 func (c *ForwardCursor) Close() error {
@@ -395,7 +415,7 @@ func (c *ForwardCursor) Close() error {
 
 // Next returns the next key/value pair in the cursor
 func (c *ForwardCursor) Next() ([]byte, []byte) {
-	if c.err != nil || c.closed {
+	if c.err != nil || c.closed || c.limited {
 		return nil, nil
 	}
 
@@ -411,6 +431,7 @@ func (c *ForwardCursor) Next() ([]byte, []byte) {
 
 	pair := c.cur[c.n]
 	c.err = pair.err
+	c.limited = pair.limit
 	c.n++
 
 	return pair.Key, pair.Value
@@ -139,6 +139,62 @@ func TestKVStore_Bucket_CursorHintPredicate(t *testing.T) {
 	})
 }
 
+func TestKVStore_Bucket_ForwardCursorLimitPredicate(t *testing.T) {
+	s := inmem.NewKVStore()
+
+	bucket := []byte("bucket")
+	err := s.Update(context.Background(), func(tx kv.Tx) error {
+		b, err := tx.Bucket(bucket)
+		if err != nil {
+			return err
+		}
+
+		for _, k := range []string{"aa/00", "aa/01", "aaa/00", "aaa/01", "aaa/02", "aaa/03"} {
+			if err := b.Put([]byte(k), []byte("val:"+k)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to put keys: %v", err)
+	}
+
+	_ = s.View(context.Background(), func(tx kv.Tx) error {
+		b, err := tx.Bucket(bucket)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		// keys dropped by the predicate still count towards the limit
+		cur, err := b.ForwardCursor(nil,
+			kv.WithCursorLimit(4),
+			kv.WithCursorHints(kv.WithCursorHintPredicate(func(key, _ []byte) bool {
+				return len(key) >= 3 && string(key[:3]) == "aaa"
+			})))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer cur.Close()
+
+		var got []string
+		for k, _ := cur.Next(); len(k) > 0; k, _ = cur.Next() {
+			got = append(got, string(k))
+		}
+
+		if exp := []string{"aaa/00", "aaa/01"}; !cmp.Equal(got, exp) {
+			t.Errorf("unexpected cursor values: -got/+exp\n%v", cmp.Diff(got, exp))
+		}
+
+		if !cur.(kv.LimitedCursor).LimitReached() {
+			t.Error("expected cursor to report limit reached")
+		}
+
+		return nil
+	})
+}
+
 func openCursor(t testing.TB, s *inmem.KVStore, bucket string, fn func(cur kv.Cursor), hints ...kv.CursorHint) {
 	t.Helper()
 
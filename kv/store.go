@@ -131,6 +131,15 @@ type ForwardCursor interface {
 	Close() error
 }
 
+// LimitedCursor is implemented by forward cursors which support
+// the WithCursorLimit option.
+type LimitedCursor interface {
+	// LimitReached returns true once the cursor has stopped returning keys
+	// because the configured limit was reached while keys remained in range.
+	// It returns false when the cursor was exhausted before hitting the limit.
+	LimitReached() bool
+}
+
 // CursorDirection is an integer used to define the direction
 // a request cursor operates in.
 type CursorDirection int
@@ -149,6 +158,7 @@ type CursorConfig struct {
 	Hints     CursorHints
 	Prefix    []byte
 	SkipFirst bool
+	Limit     *int
 }
 
 // NewCursorConfig constructs and configures a CursorConfig used to configure
@@ -199,3 +209,16 @@ func WithCursorSkipFirstItem() CursorOption {
 		c.SkipFirst = true
 	}
 }
+
+// WithCursorLimit restricts the number of keys the cursor scans to
+// the provided limit count. Every key visited within the cursor's range
+// counts towards the limit, including keys later dropped by a predicate
+// hint. A limit of zero or less scans no keys at all.
+//
+// Cursors which honour the limit implement LimitedCursor, which reports
+// whether iteration stopped at the limit rather than running out of keys.
+func WithCursorLimit(limit int) CursorOption {
+	return func(c *CursorConfig) {
+		c.Limit = &limit
+	}
+}
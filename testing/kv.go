@@ -697,12 +697,22 @@ func KVForwardCursor(
 		return p
 	}
 
+	keys := func(n int) []string {
+		k := make([]string, n)
+		for i := range k {
+			k[i] = fmt.Sprintf("key/%04d", i)
+		}
+		return k
+	}
+
 	tests := []struct {
 		name   string
 		fields KVStoreFields
 		args   args
 		exp    []string
 		expErr error
+		// expLimited is whether the cursor reports its limit was reached
+		expLimited bool
 	}{
 		{
 			name: "no hints",
@@ -774,6 +784,122 @@ func KVForwardCursor(
 			},
 			expErr: kv.ErrSeekMissingPrefix,
 		},
+		{
+			name: "limit",
+			fields: KVStoreFields{
+				Bucket: []byte("bucket"),
+				Pairs: pairs(
+					"aa/00", "aa/01",
+					"aaa/00", "aaa/01", "aaa/02", "aaa/03",
+					"bbb/00", "bbb/01", "bbb/02"),
+			},
+			args: args{
+				seek:  "aaa",
+				until: "bbb/02",
+				opts: []kv.CursorOption{
+					kv.WithCursorLimit(3),
+				},
+			},
+			exp:        []string{"aaa/00", "aaa/01", "aaa/02"},
+			expLimited: true,
+		},
+		{
+			name: "limit - exhausted at limit",
+			fields: KVStoreFields{
+				Bucket: []byte("bucket"),
+				Pairs: pairs(
+					"aa/00", "aa/01",
+					"aaa/00", "aaa/01", "aaa/02", "aaa/03",
+					"bbb/00", "bbb/01", "bbb/02"),
+			},
+			args: args{
+				seek:  "aaa/00",
+				until: "bbb/02",
+				opts: []kv.CursorOption{
+					kv.WithCursorPrefix([]byte("aaa/")),
+					kv.WithCursorLimit(4),
+				},
+			},
+			exp:        []string{"aaa/00", "aaa/01", "aaa/02", "aaa/03"},
+			expLimited: false,
+		},
+		{
+			name: "limit - zero",
+			fields: KVStoreFields{
+				Bucket: []byte("bucket"),
+				Pairs: pairs(
+					"aa/00", "aa/01",
+					"aaa/00", "aaa/01", "aaa/02", "aaa/03",
+					"bbb/00", "bbb/01", "bbb/02"),
+			},
+			args: args{
+				seek:  "aaa",
+				until: "bbb/02",
+				opts: []kv.CursorOption{
+					kv.WithCursorLimit(0),
+				},
+			},
+			expLimited: true,
+		},
+		{
+			name: "limit - negative",
+			fields: KVStoreFields{
+				Bucket: []byte("bucket"),
+				Pairs: pairs(
+					"aa/00", "aa/01",
+					"aaa/00", "aaa/01", "aaa/02", "aaa/03",
+					"bbb/00", "bbb/01", "bbb/02"),
+			},
+			args: args{
+				seek:  "aaa",
+				until: "bbb/02",
+				opts: []kv.CursorOption{
+					kv.WithCursorLimit(-1),
+				},
+			},
+			expLimited: true,
+		},
+		{
+			name: "prefix - limit",
+			fields: KVStoreFields{
+				Bucket: []byte("bucket"),
+				Pairs: pairs(
+					"aa/00", "aa/01",
+					"aaa/00", "aaa/01", "aaa/02", "aaa/03",
+					"bbb/00", "bbb/01", "bbb/02"),
+			},
+			args: args{
+				seek:  "aaa/01",
+				until: "bbb/02",
+				opts: []kv.CursorOption{
+					kv.WithCursorPrefix([]byte("aaa")),
+					kv.WithCursorLimit(10),
+				},
+			},
+			exp:        []string{"aaa/01", "aaa/02", "aaa/03"},
+			expLimited: false,
+		},
+		{
+			name: "prefix - skip first - limit",
+			fields: KVStoreFields{
+				Bucket: []byte("bucket"),
+				Pairs: pairs(
+					"aa/00", "aa/01",
+					"aaa/00", "aaa/01", "aaa/02", "aaa/03",
+					"bbb/00", "bbb/01", "bbb/02"),
+			},
+			args: args{
+				seek:  "aaa/00",
+				until: "bbb/02",
+				opts: []kv.CursorOption{
+					kv.WithCursorPrefix([]byte("aaa")),
+					kv.WithCursorSkipFirstItem(),
+					kv.WithCursorLimit(2),
+				},
+			},
+			exp:        []string{"aaa/01", "aaa/02"},
+			expLimited: true,
+		},
 		{
 			name: "prefix hint",
 			fields: KVStoreFields{
@@ -946,6 +1072,62 @@ func KVForwardCursor(
 			},
 			exp: []string{"aa/01", "aa/00"},
 		},
+		{
+			name: "limit - descending",
+			fields: KVStoreFields{
+				Bucket: []byte("bucket"),
+				Pairs: pairs(
+					"aa/00", "aa/01",
+					"aaa/00", "aaa/01", "aaa/02", "aaa/03",
+					"bbb/00", "bbb/01", "bbb/02"),
+			},
+			args: args{
+				seek:  "bbb/00",
+				until: "aa/00",
+				opts: []kv.CursorOption{
+					kv.WithCursorDirection(kv.CursorDescending),
+					kv.WithCursorLimit(3),
+				},
+			},
+			exp:        []string{"bbb/00", "aaa/03", "aaa/02"},
+			expLimited: true,
+		},
+		{
+			name: "prefixed - limit - descending",
+			fields: KVStoreFields{
+				Bucket: []byte("bucket"),
+				Pairs: pairs(
+					"aa/00", "aa/01",
+					"aaa/00", "aaa/01", "aaa/02", "aaa/03",
+					"bbb/00", "bbb/01", "bbb/02"),
+			},
+			args: args{
+				seek:  "aaa/03",
+				until: "aa/00",
+				opts: []kv.CursorOption{
+					kv.WithCursorPrefix([]byte("aaa/")),
+					kv.WithCursorDirection(kv.CursorDescending),
+					kv.WithCursorLimit(2),
+				},
+			},
+			exp:        []string{"aaa/03", "aaa/02"},
+			expLimited: true,
+		},
+		{
+			name: "limit - spans batches",
+			fields: KVStoreFields{
+				Bucket: []byte("bucket"),
+				Pairs:  pairs(keys(2500)...),
+			},
+			args: args{
+				seek: "key/",
+				opts: []kv.CursorOption{
+					kv.WithCursorLimit(1500),
+				},
+			},
+			exp:        keys(1500),
+			expLimited: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -991,6 +1173,18 @@ func KVForwardCursor(
 					t.Errorf("expected error to be %v, got %v", tt.expErr, err)
 				}
 
+				// only cursors configured with a limit must report reaching it
+				if conf := kv.NewCursorConfig(tt.args.opts...); conf.Limit != nil {
+					limited, ok := cur.(kv.LimitedCursor)
+					if !ok {
+						t.Fatalf("expected cursor %T to implement kv.LimitedCursor", cur)
+					}
+
+					if got, exp := limited.LimitReached(), tt.expLimited; got != exp {
+						t.Errorf("expected limit reached to be %v, got %v", exp, got)
+					}
+				}
+
 				if err := cur.Close(); err != nil {
 					t.Errorf("expected cursor to close with nil error, found %v", err)
 				}